@@ -0,0 +1,87 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	defaultGRPCTimeout = time.Duration(3) * time.Second
+)
+
+// GRPCConfig is used for configuring a gRPC health check against a service
+// implementing the standard `grpc.health.v1.Health` service. The only
+// required field is `Target`.
+//
+// "Service" is optional and defaults to the empty string, which per the
+// grpc_health_v1 convention checks the overall server health rather than
+// a specific service.
+//
+// "Timeout" is optional and defaults to "3s".
+type GRPCConfig struct {
+	Target      string            // Required, host:port of the gRPC server
+	Service     string            // Optional, name of the service to check
+	Timeout     time.Duration     // Optional (default 3s)
+	DialOptions []grpc.DialOption // Optional, additional dial options (e.g. TLS credentials)
+}
+
+type GRPC struct {
+	Config *GRPCConfig
+}
+
+func NewGRPC(cfg *GRPCConfig) (*GRPC, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("passed in config cannot be nil")
+	}
+
+	if err := cfg.prepare(); err != nil {
+		return nil, fmt.Errorf("unable to prepare given config: %v", err)
+	}
+
+	return &GRPC{
+		Config: cfg,
+	}, nil
+}
+
+func (g *GRPC) Status() (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.Config.Timeout)
+	defer cancel()
+
+	opts := append([]grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock()}, g.Config.DialOptions...)
+	conn, err := grpc.DialContext(ctx, g.Config.Target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to dial check target: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{
+		Service: g.Config.Service,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("errors during check request: %v", err)
+	}
+
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return resp.Status, fmt.Errorf("service reported status %v, expected %v",
+			resp.Status, grpc_health_v1.HealthCheckResponse_SERVING)
+	}
+
+	return resp.Status, nil
+}
+
+func (g *GRPCConfig) prepare() error {
+	if g.Target == "" {
+		return errors.New("Target cannot be empty")
+	}
+
+	if g.Timeout == 0 {
+		g.Timeout = defaultGRPCTimeout
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package checks
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// startHealthServer starts an in-process gRPC server hosting the standard
+// health service, pre-set to status for "service", and returns its address.
+func startHealthServer(t *testing.T, service string, status grpc_health_v1.HealthCheckResponse_ServingStatus) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(service, status)
+
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func Test_GRPC_Setup_MandatoryParams(t *testing.T) {
+	_, err := NewGRPC(&GRPCConfig{})
+	require.Error(t, err)
+}
+
+func Test_GRPC_Setup_Nil(t *testing.T) {
+	_, err := NewGRPC(nil)
+	require.Error(t, err)
+}
+
+func Test_GRPC_Setup_DefaultValues(t *testing.T) {
+	grpcCheck, err := NewGRPC(&GRPCConfig{Target: "localhost:50051"})
+	require.NoError(t, err)
+	require.Equal(t, defaultGRPCTimeout, grpcCheck.Config.Timeout)
+}
+
+func Test_GRPC_StatusCheck_Failed(t *testing.T) {
+	grpcCheck, err := NewGRPC(&GRPCConfig{Target: "127.0.0.1:1"})
+	require.NoError(t, err)
+
+	_, err = grpcCheck.Status()
+	require.Error(t, err)
+}
+
+func Test_GRPC_StatusCheck_Ok(t *testing.T) {
+	addr := startHealthServer(t, "myservice", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	grpcCheck, err := NewGRPC(&GRPCConfig{Target: addr, Service: "myservice"})
+	require.NoError(t, err)
+
+	status, err := grpcCheck.Status()
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, status)
+}
+
+func Test_GRPC_StatusCheck_NotServing(t *testing.T) {
+	addr := startHealthServer(t, "myservice", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	grpcCheck, err := NewGRPC(&GRPCConfig{Target: addr, Service: "myservice"})
+	require.NoError(t, err)
+
+	_, err = grpcCheck.Status()
+	require.Error(t, err)
+}
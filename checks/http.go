@@ -1,33 +1,79 @@
 package checks
 
 import (
+	"bytes"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 	"time"
+
+	"golang.org/x/net/http2"
 )
 
 const (
 	defaultHTTPTimeout = time.Duration(3) * time.Second
+	defaultHTTPMethod  = http.MethodGet
 )
 
+// HTTPResult is the value returned by HTTP.Status as State.Details on a
+// successful check.
+type HTTPResult struct {
+	// Duration is how long the request took to complete.
+	Duration time.Duration `json:"duration"`
+
+	// Body contains up to HTTPConfig.OutputMaxSize bytes of the response
+	// body.
+	Body string `json:"body,omitempty"`
+}
+
 // HTTPConfig is used for configuring an HTTP check. The only required field is `URL`.
 //
 // "Method" is optional and defaults to `GET` if undefined.
 //
+// "Header" is optional and, if set, is added to every check request.
+//
+// "Body" is optional and, if set, is sent as the request body, e.g. for a
+// POST/PUT probe.
+//
 // "StatusCode" is optional and defaults to `200`.
 //
-// "Client" is optional; if undefined, a new client will be created using "Timeout".
+// "Client" is optional; if undefined, a new client will be created using
+// "Timeout", "TLSClientConfig" and "EnableHTTP2".
 //
 // "Timeout" is optional and defaults to "3s".
+//
+// "TLSClientConfig" is optional and, if set, is used by the client created
+// for this check (ignored if "Client" is set).
+//
+// "EnableHTTP2" is optional and opts the created client's transport into
+// HTTP/2 (ignored if "Client" is set).
+//
+// "OutputMaxSize" is optional and defaults to 4096 bytes; it caps how much
+// of the response body is captured into State.Details.
+//
+// "ExpectedBody" is optional; if set, the response body must contain it as
+// a substring.
+//
+// "ExpectedBodyRegex" is optional; if set, the response body must match it.
 type HTTPConfig struct {
-	URL        *url.URL      // Required
-	StatusCode int           // Optional (default 200)
-	Client     *http.Client  // Optional
-	Timeout    time.Duration // Optional (default 3s)
+	URL               *url.URL       // Required
+	Method            string         // Optional (default GET)
+	Header            http.Header    // Optional
+	Body              []byte         // Optional
+	StatusCode        int            // Optional (default 200)
+	Client            *http.Client   // Optional
+	Timeout           time.Duration  // Optional (default 3s)
+	TLSClientConfig   *tls.Config    // Optional
+	EnableHTTP2       bool           // Optional
+	OutputMaxSize     int            // Optional (default 4096)
+	ExpectedBody      string         // Optional
+	ExpectedBodyRegex *regexp.Regexp // Optional
 }
 
 type HTTP struct {
@@ -54,26 +100,53 @@ func (h *HTTP) Status() (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(io.LimitReader(resp.Body, int64(h.Config.OutputMaxSize)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read check response body: %v", err)
+	}
 	io.Copy(ioutil.Discard, resp.Body)
-	// TODO FEATURE: Check response content
-	resp.Body.Close()
+
+	result := &HTTPResult{
+		Duration: time.Since(start),
+		Body:     string(bodyBytes),
+	}
 
 	// Check if StatusCode matches
 	if resp.StatusCode != h.Config.StatusCode {
-		return nil, fmt.Errorf("received status code '%v' does not match expected status code '%v'",
+		return result, fmt.Errorf("received status code '%v' does not match expected status code '%v'",
 			resp.StatusCode, h.Config.StatusCode)
 	}
 
-	return time.Since(start), nil
+	if h.Config.ExpectedBody != "" && !strings.Contains(result.Body, h.Config.ExpectedBody) {
+		return result, fmt.Errorf("response body does not contain expected content %q", h.Config.ExpectedBody)
+	}
+
+	if h.Config.ExpectedBodyRegex != nil && !h.Config.ExpectedBodyRegex.MatchString(result.Body) {
+		return result, fmt.Errorf("response body does not match expected pattern %q", h.Config.ExpectedBodyRegex.String())
+	}
+
+	return result, nil
 }
 
 func (h *HTTP) do() (*http.Response, error) {
+	var body io.Reader
+	if len(h.Config.Body) > 0 {
+		body = bytes.NewReader(h.Config.Body)
+	}
 
-	req, err := http.NewRequest("GET", h.Config.URL.String(), nil)
-	req.Close = true
+	req, err := http.NewRequest(h.Config.Method, h.Config.URL.String(), body)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create new HTTP request for check: %v", err)
 	}
+	req.Close = true
+
+	for key, values := range h.Config.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 
 	resp, err := h.Config.Client.Do(req)
 	if err != nil {
@@ -88,6 +161,10 @@ func (h *HTTPConfig) prepare() error {
 		return errors.New("URL cannot be nil")
 	}
 
+	if h.Method == "" {
+		h.Method = defaultHTTPMethod
+	}
+
 	// Default StatusCode to 200
 	if h.StatusCode == 0 {
 		h.StatusCode = http.StatusOK
@@ -97,8 +174,23 @@ func (h *HTTPConfig) prepare() error {
 		h.Timeout = defaultHTTPTimeout
 	}
 
+	if h.OutputMaxSize == 0 {
+		h.OutputMaxSize = defaultOutputMaxSize
+	}
+
 	if h.Client == nil {
-		h.Client = &http.Client{Timeout: h.Timeout}
+		transport := &http.Transport{}
+		if h.TLSClientConfig != nil {
+			transport.TLSClientConfig = h.TLSClientConfig
+		}
+
+		if h.EnableHTTP2 {
+			if err := http2.ConfigureTransport(transport); err != nil {
+				return fmt.Errorf("unable to configure HTTP/2 transport: %v", err)
+			}
+		}
+
+		h.Client = &http.Client{Timeout: h.Timeout, Transport: transport}
 	} else {
 		h.Client.Timeout = h.Timeout
 	}
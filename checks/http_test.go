@@ -1,12 +1,15 @@
 package checks
 
 import (
-	"github.com/stretchr/testify/require"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"regexp"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Setup_MandatoryParams(t *testing.T) {
@@ -27,6 +30,8 @@ func Test_SetupDefaultValues(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 200, httpCheck.Config.StatusCode)
 	require.Equal(t, defaultHTTPTimeout, httpCheck.Config.Timeout)
+	require.Equal(t, http.MethodGet, httpCheck.Config.Method)
+	require.Equal(t, defaultOutputMaxSize, httpCheck.Config.OutputMaxSize)
 	require.NotNil(t, httpCheck.Config.Client)
 	require.NotEqual(t, http.DefaultClient, httpCheck.Config.Client)
 }
@@ -81,3 +86,76 @@ func Test_StatusCheck_Failed(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func Test_StatusCheck_MethodAndHeaderAndBody(t *testing.T) {
+
+	var gotMethod, gotHeader string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		gotMethod = req.Method
+		gotHeader = req.Header.Get("X-Test")
+		gotBody, _ = ioutil.ReadAll(req.Body)
+		rw.Write([]byte(`OK`))
+	}))
+	defer server.Close()
+	parse, _ := url.Parse(server.URL)
+	httpCheck, err := NewHTTP(&HTTPConfig{
+		URL:    parse,
+		Method: http.MethodPost,
+		Header: http.Header{"X-Test": []string{"value"}},
+		Body:   []byte(`payload`),
+	})
+	require.NoError(t, err)
+
+	_, err = httpCheck.Status()
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPost, gotMethod)
+	require.Equal(t, "value", gotHeader)
+	require.Equal(t, []byte(`payload`), gotBody)
+}
+
+func Test_StatusCheck_ExpectedBody(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`all systems go`))
+	}))
+	defer server.Close()
+	parse, _ := url.Parse(server.URL)
+
+	httpCheck, _ := NewHTTP(&HTTPConfig{
+		URL:          parse,
+		ExpectedBody: "systems go",
+	})
+	_, err := httpCheck.Status()
+	require.NoError(t, err)
+
+	httpCheck, _ = NewHTTP(&HTTPConfig{
+		URL:          parse,
+		ExpectedBody: "all is lost",
+	})
+	_, err = httpCheck.Status()
+	require.Error(t, err)
+}
+
+func Test_StatusCheck_ExpectedBodyRegex(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`version: 1.2.3`))
+	}))
+	defer server.Close()
+	parse, _ := url.Parse(server.URL)
+
+	httpCheck, _ := NewHTTP(&HTTPConfig{
+		URL:               parse,
+		ExpectedBodyRegex: regexp.MustCompile(`version: \d+\.\d+\.\d+`),
+	})
+	_, err := httpCheck.Status()
+	require.NoError(t, err)
+
+	httpCheck, _ = NewHTTP(&HTTPConfig{
+		URL:               parse,
+		ExpectedBodyRegex: regexp.MustCompile(`^nope$`),
+	})
+	_, err = httpCheck.Status()
+	require.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package checks
+
+// ringBuffer is a bounded io.Writer that retains only the most recently
+// written `size` bytes, discarding the oldest data once full. It backs
+// Script's captured stdout/stderr so a runaway command can't grow
+// State.Details without bound.
+type ringBuffer struct {
+	buf   []byte
+	start int
+	full  bool
+}
+
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{
+		buf: make([]byte, size),
+	}
+}
+
+func (r *ringBuffer) Write(p []byte) (int, error) {
+	size := len(r.buf)
+	for _, b := range p {
+		r.buf[r.start] = b
+		r.start++
+		if r.start == size {
+			r.start = 0
+			r.full = true
+		}
+	}
+	return len(p), nil
+}
+
+func (r *ringBuffer) String() string {
+	if !r.full {
+		return string(r.buf[:r.start])
+	}
+
+	out := make([]byte, len(r.buf))
+	copy(out, r.buf[r.start:])
+	copy(out[len(r.buf)-r.start:], r.buf[:r.start])
+	return string(out)
+}
@@ -0,0 +1,103 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+const (
+	defaultScriptTimeout = time.Duration(30) * time.Second
+	defaultOutputMaxSize = 4 * 1024
+
+	// MinInterval is the minimum amount of time that must pass between two
+	// runs of a Script check, to avoid fork-bombing the host if it's
+	// misconfigured with an overly aggressive interval.
+	MinInterval = time.Second
+)
+
+// ScriptConfig is used for configuring a Script check that runs a local
+// command via `os/exec`. The only required field is `Command`.
+//
+// "Timeout" is optional and defaults to "30s".
+//
+// "OutputMaxSize" is optional and defaults to 4096 bytes; captured
+// stdout/stderr beyond this size is discarded, oldest first.
+type ScriptConfig struct {
+	Command       []string      // Required, e.g. []string{"/bin/sh", "-c", "exit 0"}
+	Timeout       time.Duration // Optional (default 30s)
+	OutputMaxSize int           // Optional (default 4096)
+}
+
+type Script struct {
+	Config *ScriptConfig
+
+	mu      sync.Mutex
+	lastRun time.Time
+}
+
+func NewScript(cfg *ScriptConfig) (*Script, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("passed in config cannot be nil")
+	}
+
+	if err := cfg.prepare(); err != nil {
+		return nil, fmt.Errorf("unable to prepare given config: %v", err)
+	}
+
+	return &Script{
+		Config: cfg,
+	}, nil
+}
+
+// Status runs Config.Command, capturing its combined stdout/stderr into a
+// ring buffer bounded by Config.OutputMaxSize. Exit code 0 is a pass;
+// any other exit code (or a failure to start/complete the command) is a
+// failure, with the captured output returned so it can be surfaced via
+// State.Details.
+func (s *Script) Status() (interface{}, error) {
+	s.mu.Lock()
+	if since := time.Since(s.lastRun); !s.lastRun.IsZero() && since < MinInterval {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("script check invoked too soon: %v since last run, minimum interval is %v", since, MinInterval)
+	}
+	s.lastRun = time.Now()
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.Config.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.Config.Command[0], s.Config.Command[1:]...)
+
+	output := newRingBuffer(s.Config.OutputMaxSize)
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	err := cmd.Run()
+	details := output.String()
+
+	if err != nil {
+		return details, fmt.Errorf("check command failed: %v", err)
+	}
+
+	return details, nil
+}
+
+func (s *ScriptConfig) prepare() error {
+	if len(s.Command) == 0 {
+		return errors.New("Command cannot be empty")
+	}
+
+	if s.Timeout == 0 {
+		s.Timeout = defaultScriptTimeout
+	}
+
+	if s.OutputMaxSize == 0 {
+		s.OutputMaxSize = defaultOutputMaxSize
+	}
+
+	return nil
+}
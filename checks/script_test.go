@@ -0,0 +1,53 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Script_Setup_MandatoryParams(t *testing.T) {
+	_, err := NewScript(&ScriptConfig{})
+	require.Error(t, err)
+}
+
+func Test_Script_Setup_Nil(t *testing.T) {
+	_, err := NewScript(nil)
+	require.Error(t, err)
+}
+
+func Test_Script_Setup_DefaultValues(t *testing.T) {
+	scriptCheck, err := NewScript(&ScriptConfig{Command: []string{"true"}})
+	require.NoError(t, err)
+	require.Equal(t, defaultScriptTimeout, scriptCheck.Config.Timeout)
+	require.Equal(t, defaultOutputMaxSize, scriptCheck.Config.OutputMaxSize)
+}
+
+func Test_Script_StatusCheck_Ok(t *testing.T) {
+	scriptCheck, err := NewScript(&ScriptConfig{Command: []string{"/bin/sh", "-c", "echo hello"}})
+	require.NoError(t, err)
+
+	status, err := scriptCheck.Status()
+	require.NoError(t, err)
+	require.Contains(t, status, "hello")
+}
+
+func Test_Script_StatusCheck_Failed(t *testing.T) {
+	scriptCheck, err := NewScript(&ScriptConfig{Command: []string{"/bin/sh", "-c", "echo boom >&2; exit 1"}})
+	require.NoError(t, err)
+
+	status, err := scriptCheck.Status()
+	require.Error(t, err)
+	require.Contains(t, status, "boom")
+}
+
+func Test_Script_EnforcesMinInterval(t *testing.T) {
+	scriptCheck, err := NewScript(&ScriptConfig{Command: []string{"true"}})
+	require.NoError(t, err)
+
+	_, err = scriptCheck.Status()
+	require.NoError(t, err)
+
+	_, err = scriptCheck.Status()
+	require.Error(t, err)
+}
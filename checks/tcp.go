@@ -0,0 +1,64 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	defaultTCPTimeout = time.Duration(3) * time.Second
+)
+
+// TCPConfig is used for configuring a TCP check. The only required field is `Addr`.
+//
+// "Timeout" is optional and defaults to "3s".
+type TCPConfig struct {
+	Addr    string        // Required, host:port to dial
+	Timeout time.Duration // Optional (default 3s)
+}
+
+type TCP struct {
+	Config *TCPConfig
+}
+
+func NewTCP(cfg *TCPConfig) (*TCP, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("passed in config cannot be nil")
+	}
+
+	if err := cfg.prepare(); err != nil {
+		return nil, fmt.Errorf("unable to prepare given config: %v", err)
+	}
+
+	return &TCP{
+		Config: cfg,
+	}, nil
+}
+
+// Status passes if a TCP connection to Config.Addr can be established
+// within Config.Timeout.
+func (t *TCP) Status() (interface{}, error) {
+	start := time.Now()
+
+	conn, err := net.DialTimeout("tcp", t.Config.Addr, t.Config.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("errors during check dial: %v", err)
+	}
+	conn.Close()
+
+	return time.Since(start), nil
+}
+
+func (t *TCPConfig) prepare() error {
+	if t.Addr == "" {
+		return errors.New("Addr cannot be empty")
+	}
+
+	if t.Timeout == 0 {
+		t.Timeout = defaultTCPTimeout
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package checks
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TCP_Setup_MandatoryParams(t *testing.T) {
+	_, err := NewTCP(&TCPConfig{})
+	require.Error(t, err)
+}
+
+func Test_TCP_Setup_Nil(t *testing.T) {
+	_, err := NewTCP(nil)
+	require.Error(t, err)
+}
+
+func Test_TCP_Setup_DefaultValues(t *testing.T) {
+	tcpCheck, err := NewTCP(&TCPConfig{Addr: "localhost:0"})
+	require.NoError(t, err)
+	require.Equal(t, defaultTCPTimeout, tcpCheck.Config.Timeout)
+}
+
+func Test_TCP_StatusCheck_Ok(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	tcpCheck, err := NewTCP(&TCPConfig{Addr: listener.Addr().String()})
+	require.NoError(t, err)
+
+	status, err := tcpCheck.Status()
+	require.NoError(t, err)
+	require.NotNil(t, status)
+}
+
+func Test_TCP_StatusCheck_Failed(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	tcpCheck, err := NewTCP(&TCPConfig{Addr: addr})
+	require.NoError(t, err)
+
+	_, err = tcpCheck.Status()
+	require.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	defaultUDPTimeout = time.Duration(3) * time.Second
+	udpProbePayload   = "sparetimecoders-monitor-check"
+)
+
+// UDPConfig is used for configuring a UDP check. The only required field is `Addr`.
+//
+// "Timeout" is optional and defaults to "3s".
+type UDPConfig struct {
+	Addr    string        // Required, host:port to send the probe to
+	Timeout time.Duration // Optional (default 3s)
+}
+
+type UDP struct {
+	Config *UDPConfig
+}
+
+func NewUDP(cfg *UDPConfig) (*UDP, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("passed in config cannot be nil")
+	}
+
+	if err := cfg.prepare(); err != nil {
+		return nil, fmt.Errorf("unable to prepare given config: %v", err)
+	}
+
+	return &UDP{
+		Config: cfg,
+	}, nil
+}
+
+// Status sends a probe payload to Config.Addr. Since UDP is connectionless,
+// a matching read-back is treated as passing, and so is a plain read
+// timeout, as the target may simply not echo anything back. Only an ICMP
+// port-unreachable (surfaced as a non-timeout error on read or write) is
+// treated as a failure.
+func (u *UDP) Status() (interface{}, error) {
+	start := time.Now()
+
+	conn, err := net.DialTimeout("udp", u.Config.Addr, u.Config.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("errors during check dial: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(u.Config.Timeout)); err != nil {
+		return nil, fmt.Errorf("unable to set deadline for check: %v", err)
+	}
+
+	if _, err := conn.Write([]byte(udpProbePayload)); err != nil {
+		if netErr, ok := err.(net.Error); !ok || !netErr.Timeout() {
+			return nil, fmt.Errorf("errors writing check probe: %v", err)
+		}
+	}
+
+	buf := make([]byte, len(udpProbePayload))
+	if _, err := conn.Read(buf); err != nil {
+		if netErr, ok := err.(net.Error); !ok || !netErr.Timeout() {
+			return nil, fmt.Errorf("errors during check read: %v", err)
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+func (u *UDPConfig) prepare() error {
+	if u.Addr == "" {
+		return errors.New("Addr cannot be empty")
+	}
+
+	if u.Timeout == 0 {
+		u.Timeout = defaultUDPTimeout
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package checks
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UDP_Setup_MandatoryParams(t *testing.T) {
+	_, err := NewUDP(&UDPConfig{})
+	require.Error(t, err)
+}
+
+func Test_UDP_Setup_Nil(t *testing.T) {
+	_, err := NewUDP(nil)
+	require.Error(t, err)
+}
+
+func Test_UDP_Setup_DefaultValues(t *testing.T) {
+	udpCheck, err := NewUDP(&UDPConfig{Addr: "localhost:0"})
+	require.NoError(t, err)
+	require.Equal(t, defaultUDPTimeout, udpCheck.Config.Timeout)
+}
+
+func Test_UDP_StatusCheck_Echo(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	go func() {
+		buf := make([]byte, 1024)
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		conn.WriteTo(buf[:n], addr)
+	}()
+
+	udpCheck, err := NewUDP(&UDPConfig{Addr: conn.LocalAddr().String()})
+	require.NoError(t, err)
+
+	status, err := udpCheck.Status()
+	require.NoError(t, err)
+	require.NotNil(t, status)
+}
+
+func Test_UDP_StatusCheck_TimeoutIsNotAFailure(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	udpCheck, err := NewUDP(&UDPConfig{Addr: conn.LocalAddr().String(), Timeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+
+	_, err = udpCheck.Status()
+	require.NoError(t, err)
+}
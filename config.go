@@ -1,9 +1,17 @@
 package monitor
 
 import (
+	"fmt"
 	"time"
 )
 
+// MinInterval is the lowest Config.Interval that AddCheck and NewConfig
+// will accept. It exists because a zero or negative interval would make
+// the runner's timer fire back-to-back, and anything much lower risks
+// hammering whatever the check targets. Overridable, e.g. in tests that
+// need a tighter interval.
+var MinInterval = time.Second
+
 type Config struct {
 	// Name of the check
 	Name string
@@ -11,15 +19,55 @@ type Config struct {
 	// Checker instance used to perform health check
 	Checker Checker
 
-	// Interval between health checks
+	// Interval between health checks. Must be >= MinInterval.
 	Interval time.Duration
 
 	// Hook that gets called when this health check is complete
 	OnComplete func(state *State)
+
+	// SuccessBeforePassing is the number of consecutive successful results
+	// required before the externally-visible status is reported as
+	// StatusPassing again after having been warning/critical.
+	// Optional, defaults to 1.
+	SuccessBeforePassing int
+
+	// FailuresBeforeWarning is the number of consecutive failing results
+	// required before the externally-visible status is reported as
+	// StatusWarning. Optional, defaults to 1. Must be set lower than
+	// FailuresBeforeCritical to be observable: critical is checked first,
+	// so if both are left at their default of 1 the very first failure
+	// goes straight to StatusCritical.
+	FailuresBeforeWarning int
+
+	// FailuresBeforeCritical is the number of consecutive failing results
+	// required before the externally-visible status is reported as
+	// StatusCritical. Optional, defaults to 1.
+	FailuresBeforeCritical int
+
+	// BackoffFactor, if greater than 1, grows the effective interval
+	// between runs by this factor after each contiguous failure, up to
+	// MaxInterval, resetting to Interval on the next success. Optional,
+	// defaults to 1 (no backoff).
+	BackoffFactor float64
+
+	// MaxInterval caps the effective interval once BackoffFactor is
+	// applied. Optional, defaults to Interval (i.e. no backoff) if
+	// BackoffFactor is set but MaxInterval isn't.
+	MaxInterval time.Duration
+
+	// JitterPercent spreads each run's effective interval by up to
+	// ±JitterPercent% at random, to avoid many checks sharing an Interval
+	// firing in lockstep. Must be between 0 and 100. Optional, defaults to
+	// 0 (no jitter). The jittered interval is always floored at
+	// MinInterval, however high JitterPercent is set.
+	JitterPercent float64
 }
 
 func NewConfig(name string, checker Checker, interval time.Duration, onComplete func(state *State)) (*Config, error) {
-	// TODO Check input
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+
 	return &Config{
 		Name:       name,
 		Checker:    checker,
@@ -27,3 +75,38 @@ func NewConfig(name string, checker Checker, interval time.Duration, onComplete
 		OnComplete: onComplete,
 	}, nil
 }
+
+func validateInterval(interval time.Duration) error {
+	if interval < MinInterval {
+		return fmt.Errorf("interval %v is below the minimum allowed interval %v", interval, MinInterval)
+	}
+	return nil
+}
+
+func validateJitterPercent(jitterPercent float64) error {
+	if jitterPercent < 0 || jitterPercent > 100 {
+		return fmt.Errorf("jitter percent %v must be between 0 and 100", jitterPercent)
+	}
+	return nil
+}
+
+func (c *Config) successBeforePassing() int {
+	if c.SuccessBeforePassing <= 0 {
+		return 1
+	}
+	return c.SuccessBeforePassing
+}
+
+func (c *Config) failuresBeforeWarning() int {
+	if c.FailuresBeforeWarning <= 0 {
+		return 1
+	}
+	return c.FailuresBeforeWarning
+}
+
+func (c *Config) failuresBeforeCritical() int {
+	if c.FailuresBeforeCritical <= 0 {
+		return 1
+	}
+	return c.FailuresBeforeCritical
+}
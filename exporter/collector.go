@@ -0,0 +1,101 @@
+// Package exporter adapts a *monitor.Monitor into Prometheus metrics and
+// a pull-based /health HTTP endpoint.
+package exporter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	monitor "github.com/sparetimecoders/monitor"
+)
+
+const namespace = "monitor"
+
+// Collector is a prometheus.Collector publishing the current state of
+// every check registered on a Monitor. Each scrape samples the last
+// recorded run via mon.Snapshot(); check_duration_seconds is only
+// observed once per check per run (tracked via State.CheckTime), so
+// repeated scrapes between runs don't re-observe the same duration.
+type Collector struct {
+	mon *monitor.Monitor
+
+	up                 *prometheus.Desc
+	duration           *prometheus.HistogramVec
+	failuresTotal      *prometheus.Desc
+	contiguousFailures *prometheus.Desc
+
+	mu             sync.Mutex
+	prevStatus     map[string]string
+	totalFailures  map[string]uint64
+	lastCheckTimes map[string]time.Time
+}
+
+// NewCollector returns a Collector that reads mon's state via
+// mon.Snapshot() on every scrape.
+func NewCollector(mon *monitor.Monitor) *Collector {
+	return &Collector{
+		mon: mon,
+		up: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "check_up"),
+			"Whether the check is currently passing (1) or not (0).",
+			[]string{"name"}, nil,
+		),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "check_duration_seconds",
+			Help:      "How long each check run took, in seconds.",
+		}, []string{"name"}),
+		failuresTotal: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "check_failures_total"),
+			"Total number of times the check has been seen, between scrapes, transitioning into a failing state.",
+			[]string{"name"}, nil,
+		),
+		contiguousFailures: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "check_contiguous_failures"),
+			"Number of consecutive runs for which the check was reported as warning or critical.",
+			[]string{"name"}, nil,
+		),
+		prevStatus:     make(map[string]string),
+		totalFailures:  make(map[string]uint64),
+		lastCheckTimes: make(map[string]time.Time),
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.up
+	ch <- c.failuresTotal
+	ch <- c.contiguousFailures
+	c.duration.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, state := range c.mon.Snapshot() {
+		up := 0.0
+		if state.Status == monitor.StatusPassing {
+			up = 1.0
+		}
+
+		failing := state.Status == monitor.StatusWarning || state.Status == monitor.StatusCritical
+		wasFailing := c.prevStatus[name] == monitor.StatusWarning || c.prevStatus[name] == monitor.StatusCritical
+		if failing && !wasFailing {
+			c.totalFailures[name]++
+		}
+		c.prevStatus[name] = state.Status
+
+		ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, up, name)
+		ch <- prometheus.MustNewConstMetric(c.failuresTotal, prometheus.CounterValue, float64(c.totalFailures[name]), name)
+		ch <- prometheus.MustNewConstMetric(c.contiguousFailures, prometheus.GaugeValue, float64(state.ContiguousFailures), name)
+
+		if state.CheckTime.After(c.lastCheckTimes[name]) {
+			c.lastCheckTimes[name] = state.CheckTime
+			c.duration.WithLabelValues(name).Observe(state.Duration.Seconds())
+		}
+	}
+
+	c.duration.Collect(ch)
+}
@@ -0,0 +1,80 @@
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	monitor "github.com/sparetimecoders/monitor"
+)
+
+func Test_Collector_ReportsCheckUp(t *testing.T) {
+	mon := monitor.New()
+	mon.RandomStartTimeMillis = func() int { return 0 }
+	require.NoError(t, mon.AddCheck(&monitor.Config{
+		Name:     "test",
+		Checker:  &fakeChecker{},
+		Interval: time.Hour,
+	}))
+	require.NoError(t, mon.Start())
+	defer mon.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	collector := NewCollector(mon)
+
+	count, err := testutil.GatherAndCount(prometheusRegistryFor(collector), "monitor_check_up")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func Test_Collector_OnlyObservesDurationOncePerRun(t *testing.T) {
+	mon := monitor.New()
+	mon.RandomStartTimeMillis = func() int { return 0 }
+	require.NoError(t, mon.AddCheck(&monitor.Config{
+		Name:     "test",
+		Checker:  &fakeChecker{},
+		Interval: time.Hour,
+	}))
+	require.NoError(t, mon.Start())
+	defer mon.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	reg := prometheusRegistryFor(NewCollector(mon))
+
+	for i := 0; i < 3; i++ {
+		_, err := reg.Gather()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, uint64(1), durationSampleCount(t, reg, "test"))
+}
+
+func durationSampleCount(t *testing.T, reg *prometheus.Registry, checkName string) uint64 {
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "monitor_check_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" && label.GetValue() == checkName {
+					return metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+
+	t.Fatalf("no monitor_check_duration_seconds sample found for check %s", checkName)
+	return 0
+}
+
+func prometheusRegistryFor(collector prometheus.Collector) *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collector)
+	return reg
+}
@@ -0,0 +1,63 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	monitor "github.com/sparetimecoders/monitor"
+)
+
+// Handler is an http.Handler serving the JSON state of a Monitor's
+// checks. Mounted at "/health", it returns the aggregate state of every
+// check with a 200 if all are passing or a 503 if any are not; mounted
+// at "/health/{name}", it returns the state of that single check.
+type Handler struct {
+	mon *monitor.Monitor
+}
+
+// NewHandler returns a Handler backed by mon.
+func NewHandler(mon *monitor.Monitor) *Handler {
+	return &Handler{mon: mon}
+}
+
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	name := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, "/health"), "/")
+
+	rw.Header().Set("Content-Type", "application/json")
+
+	if name != "" {
+		h.serveSingle(rw, name)
+		return
+	}
+
+	h.serveAll(rw)
+}
+
+func (h *Handler) serveSingle(rw http.ResponseWriter, name string) {
+	state, ok := h.mon.Snapshot()[name]
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(rw).Encode(map[string]string{"error": fmt.Sprintf("no check found with name %s", name)})
+		return
+	}
+
+	if state.Status != monitor.StatusPassing {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(rw).Encode(state)
+}
+
+func (h *Handler) serveAll(rw http.ResponseWriter) {
+	states := h.mon.Snapshot()
+
+	for _, state := range states {
+		if state.Status != monitor.StatusPassing {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			break
+		}
+	}
+
+	json.NewEncoder(rw).Encode(states)
+}
@@ -0,0 +1,86 @@
+package exporter
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	monitor "github.com/sparetimecoders/monitor"
+)
+
+type fakeChecker struct {
+	err error
+}
+
+func (f *fakeChecker) Status() (interface{}, error) {
+	return "details", f.err
+}
+
+func Test_Handler_AllPassing(t *testing.T) {
+	mon := monitor.New()
+	mon.RandomStartTimeMillis = func() int { return 0 }
+	require.NoError(t, mon.AddCheck(&monitor.Config{
+		Name:     "test",
+		Checker:  &fakeChecker{},
+		Interval: time.Hour,
+	}))
+	require.NoError(t, mon.Start())
+	defer mon.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	handler := NewHandler(mon)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	require.Equal(t, http.StatusOK, rw.Code)
+
+	var states map[string]monitor.State
+	require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &states))
+	require.Contains(t, states, "test")
+}
+
+func Test_Handler_OneFailing(t *testing.T) {
+	mon := monitor.New()
+	mon.RandomStartTimeMillis = func() int { return 0 }
+	require.NoError(t, mon.AddCheck(&monitor.Config{
+		Name:     "test",
+		Checker:  &fakeChecker{err: errors.New("failure")},
+		Interval: time.Hour,
+	}))
+	require.NoError(t, mon.Start())
+	defer mon.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	handler := NewHandler(mon)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, rw.Code)
+}
+
+func Test_Handler_SingleCheck(t *testing.T) {
+	mon := monitor.New()
+	mon.RandomStartTimeMillis = func() int { return 0 }
+	require.NoError(t, mon.AddCheck(&monitor.Config{
+		Name:     "test",
+		Checker:  &fakeChecker{},
+		Interval: time.Hour,
+	}))
+	require.NoError(t, mon.Start())
+	defer mon.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	handler := NewHandler(mon)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/health/test", nil))
+	require.Equal(t, http.StatusOK, rw.Code)
+
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/health/unknown", nil))
+	require.Equal(t, http.StatusNotFound, rw.Code)
+}
@@ -0,0 +1,71 @@
+package monitor
+
+import (
+	"math/rand"
+	"time"
+)
+
+// intervalScheduler computes the delay before a runner's next check,
+// applying Config.BackoffFactor growth while a check keeps failing and
+// Config.JitterPercent spread on every tick.
+type intervalScheduler struct {
+	cfg *Config
+
+	contiguousFailures int
+}
+
+func newIntervalScheduler(cfg *Config) *intervalScheduler {
+	return &intervalScheduler{cfg: cfg}
+}
+
+// failed records a failing result, growing the next interval returned by
+// next() if the Config has backoff configured.
+func (s *intervalScheduler) failed() {
+	s.contiguousFailures++
+}
+
+// recovered records a successful result, resetting any accumulated backoff.
+func (s *intervalScheduler) recovered() {
+	s.contiguousFailures = 0
+}
+
+// next returns the delay to wait before the following check run.
+func (s *intervalScheduler) next() time.Duration {
+	interval := s.cfg.Interval
+
+	if s.cfg.BackoffFactor > 1 && s.contiguousFailures > 0 {
+		maxInterval := s.cfg.MaxInterval
+		if maxInterval <= 0 {
+			maxInterval = interval
+		}
+
+		for i := 0; i < s.contiguousFailures && interval < maxInterval; i++ {
+			interval = time.Duration(float64(interval) * s.cfg.BackoffFactor)
+		}
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+
+	interval = applyJitter(interval, s.cfg.JitterPercent)
+	if interval < MinInterval {
+		interval = MinInterval
+	}
+	return interval
+}
+
+// applyJitter spreads interval by up to +/-jitterPercent% at random.
+func applyJitter(interval time.Duration, jitterPercent float64) time.Duration {
+	if jitterPercent <= 0 {
+		return interval
+	}
+
+	spread := float64(interval) * (jitterPercent / 100)
+	delta := (rand.Float64()*2 - 1) * spread
+
+	result := interval + time.Duration(delta)
+	if result < 0 {
+		return 0
+	}
+	return result
+}
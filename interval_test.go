@@ -0,0 +1,64 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IntervalScheduler_NoBackoffByDefault(t *testing.T) {
+	cfg := &Config{Interval: time.Second}
+	s := newIntervalScheduler(cfg)
+
+	s.failed()
+	s.failed()
+
+	require.Equal(t, time.Second, s.next())
+}
+
+func Test_IntervalScheduler_BackoffGrowsAndCaps(t *testing.T) {
+	cfg := &Config{
+		Interval:      time.Second,
+		BackoffFactor: 2,
+		MaxInterval:   4 * time.Second,
+	}
+	s := newIntervalScheduler(cfg)
+
+	s.failed()
+	require.Equal(t, 2*time.Second, s.next())
+
+	s.failed()
+	require.Equal(t, 4*time.Second, s.next())
+
+	s.failed()
+	require.Equal(t, 4*time.Second, s.next(), "should not exceed MaxInterval")
+
+	s.recovered()
+	require.Equal(t, time.Second, s.next(), "should reset after a success")
+}
+
+func Test_ApplyJitter_WithinBounds(t *testing.T) {
+	interval := 10 * time.Second
+	for i := 0; i < 100; i++ {
+		jittered := applyJitter(interval, 10)
+		require.GreaterOrEqual(t, jittered, 9*time.Second)
+		require.LessOrEqual(t, jittered, 11*time.Second)
+	}
+}
+
+func Test_ApplyJitter_ZeroPercentIsNoOp(t *testing.T) {
+	require.Equal(t, 10*time.Second, applyJitter(10*time.Second, 0))
+}
+
+func Test_IntervalScheduler_Next_FloorsAtMinInterval(t *testing.T) {
+	cfg := &Config{
+		Interval:      2 * MinInterval,
+		JitterPercent: 250,
+	}
+	s := newIntervalScheduler(cfg)
+
+	for i := 0; i < 1000; i++ {
+		require.GreaterOrEqual(t, s.next(), MinInterval)
+	}
+}
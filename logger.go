@@ -0,0 +1,28 @@
+package monitor
+
+import (
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// NewTextLogger returns an hclog.Logger that writes human-readable text
+// to os.Stderr, suitable for local development.
+func NewTextLogger(name string, level hclog.Level) hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{
+		Name:   name,
+		Level:  level,
+		Output: os.Stderr,
+	})
+}
+
+// NewJSONLogger returns an hclog.Logger that writes structured JSON to
+// os.Stderr, suitable for ingestion by log pipelines.
+func NewJSONLogger(name string, level hclog.Level) hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{
+		Name:       name,
+		Level:      level,
+		Output:     os.Stderr,
+		JSONFormat: true,
+	})
+}
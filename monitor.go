@@ -1,43 +1,71 @@
 package monitor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/hashicorp/go-hclog"
 )
 
 // Monitor contains internal go-health internal structures.
 type Monitor struct {
 	// StatusListener will report failures and recoveries
 	StatusListener StatusListener
-	// RandomStartTimeMillis returns a random delay to wait before starting the checks (one for each check)
+	// RandomStartTimeMillis returns a random delay to wait before starting
+	// each check, spreading their initial runs out instead of firing them
+	// all at once. Defaults to 0 (no delay); prefer Config.JitterPercent
+	// to spread out steady-state ticks sharing the same Interval.
 	RandomStartTimeMillis func() int
+	// Logger receives structured, per-check log output. Defaults to a
+	// no-op logger; use NewTextLogger or NewJSONLogger to enable output.
+	Logger hclog.Logger
 
 	configs     []*Config
 	states      map[string]State
 	statesLock  sync.Mutex
 	runnersLock sync.Mutex
-	runners     map[string]chan struct{} // contains map of active runners w/ a stop channel
+	runners     map[string]*runner // contains map of active runners, keyed by check name
 	started     bool
 }
 
+// runner tracks the lifecycle of a single running check: cancelling
+// cancel signals the goroutine started by startRunner to stop, and wg
+// reaches zero once that goroutine - including its final in-flight
+// checkFunc and any callback it dispatched - has returned.
+type runner struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
 // New returns a new instance of the Monitor struct.
 func New() *Monitor {
 	return &Monitor{
 		configs:     make([]*Config, 0),
 		states:      make(map[string]State, 0),
-		runners:     make(map[string]chan struct{}, 0),
+		runners:     make(map[string]*runner, 0),
 		statesLock:  sync.Mutex{},
 		runnersLock: sync.Mutex{},
 		RandomStartTimeMillis: func() int {
 			return 0
 		},
+		Logger: hclog.NewNullLogger(),
 	}
 }
 
 // AddCheck is used for adding a single check definition to the current health instance.
 func (h *Monitor) AddCheck(cfg ...*Config) error {
+	for _, c := range cfg {
+		if err := validateInterval(c.Interval); err != nil {
+			return err
+		}
+		if err := validateJitterPercent(c.JitterPercent); err != nil {
+			return err
+		}
+	}
+
 	for _, existing := range h.configs {
 		for _, c := range cfg {
 			if c.Name == existing.Name {
@@ -56,10 +84,10 @@ func (h *Monitor) RemoveCheck(cfg *Config) error {
 				if err := h.StopCheck(cfg.Name); err != nil {
 					return err
 				}
-				fmt.Printf("stopped check %s\n", cfg.Name)
+				h.Logger.Info("stopped check", "check", cfg.Name)
 			}
 			h.configs = append(h.configs[:idx], h.configs[idx+1:]...)
-			fmt.Printf("removed check %s\n", cfg.Name)
+			h.Logger.Info("removed check", "check", cfg.Name)
 			return nil
 		}
 	}
@@ -69,38 +97,48 @@ func (h *Monitor) RemoveCheck(cfg *Config) error {
 // Start will start all of the defined health checks. Each of the checks run in
 // their own goroutines (as "time.Ticker").
 func (h *Monitor) Start() error {
+	h.runnersLock.Lock()
+	defer h.runnersLock.Unlock()
+
 	if h.started {
 		return errors.New("monitor already started")
 	}
 	h.started = true
+
 	for _, c := range h.configs {
-		h.startRunnerForConfig(c)
+		h.startRunnerLocked(c)
 	}
 
 	return nil
 }
 
-func (h *Monitor) startRunnerForConfig(c *Config) {
-	stop := make(chan struct{})
-	h.startRunner(c, stop)
-	h.runnersLock.Lock()
-	defer h.runnersLock.Unlock()
-	h.runners[c.Name] = stop
-	fmt.Printf("started check %s\n", c.Name)
+// startRunnerLocked starts cfg's runner goroutine and registers it. The
+// caller must hold h.runnersLock.
+func (h *Monitor) startRunnerLocked(c *Config) {
+	ctx, cancel := context.WithCancel(context.Background())
 
+	r := &runner{cancel: cancel}
+	r.wg.Add(1)
+	h.runners[c.Name] = r
+	go h.startRunner(ctx, c, &r.wg)
+	h.Logger.Info("started check", "check", c.Name)
 }
 
 func (h *Monitor) StopCheck(name string) error {
 	h.runnersLock.Lock()
 	defer h.runnersLock.Unlock()
 
-	if stop := h.runners[name]; stop != nil {
-		fmt.Printf("stopping check %s\n", name)
-		close(stop)
-		delete(h.runners, name)
-	} else {
+	r := h.runners[name]
+	if r == nil {
 		return fmt.Errorf("failed to find check with name %s", name)
 	}
+	h.Logger.Info("stopping check", "check", name)
+	delete(h.runners, name)
+
+	// held for the duration of the wait so a concurrent StartCheck for the
+	// same name can't race the outgoing runner's final checkFunc
+	r.cancel()
+	r.wg.Wait()
 
 	// Reset state
 	h.statesLock.Lock()
@@ -119,29 +157,40 @@ func (h *Monitor) StartCheck(name string) error {
 	if found == nil {
 		return fmt.Errorf("failed to find check with name %s", name)
 	}
-	if stop := h.runners[name]; stop != nil {
+
+	h.runnersLock.Lock()
+	defer h.runnersLock.Unlock()
+
+	if _, running := h.runners[name]; running {
 		return fmt.Errorf("check already running")
-	} else {
-		h.startRunnerForConfig(found)
 	}
+
+	h.startRunnerLocked(found)
 	return nil
 }
 
-// Stop will cause all of the running health checks to be stopped. Additionally,
-// all existing check states will be reset.
+// Stop will cause all of the running health checks to be stopped, waiting
+// for each to fully shut down - including any in-flight checkFunc run and
+// dispatched callback - before returning. Additionally, all existing
+// check states will be reset.
 func (h *Monitor) Stop() error {
-	for name, stop := range h.runners {
-		fmt.Printf("Stopping check %s\n", name)
-		close(stop)
+	h.runnersLock.Lock()
+	runners := h.runners
+	h.runners = make(map[string]*runner, 0)
+	h.started = false
+	h.runnersLock.Unlock()
+
+	for name, r := range runners {
+		h.Logger.Info("stopping check", "check", name)
+		r.cancel()
+	}
+	for _, r := range runners {
+		r.wg.Wait()
 	}
-	time.Sleep(time.Second)
-	// Reset runner map
-	h.runners = make(map[string]chan struct{}, 0)
 
 	// Reset states
 	h.safeResetStates()
 
-	h.started = false
 	return nil
 }
 
@@ -149,52 +198,85 @@ func (h *Monitor) State() (map[string]State, error) {
 	return h.safeGetStates(), nil
 }
 
-func (h *Monitor) startRunner(cfg *Config,
-	stop <-chan struct{}) {
+// Snapshot returns a point-in-time, concurrency-safe copy of all check
+// states, keyed by check name. Unlike State, it cannot fail.
+func (h *Monitor) Snapshot() map[string]State {
+	return h.safeGetStates()
+}
+
+func (h *Monitor) startRunner(ctx context.Context, cfg *Config, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	handler := newStatusHandler(cfg)
+	schedule := newIntervalScheduler(cfg)
+	logger := h.Logger.With("check", cfg.Name)
 
 	checkFunc := func() {
+		start := time.Now()
 		data, err := cfg.Checker.Status()
+		duration := time.Since(start)
+
+		status := handler.next(err)
+		if err != nil {
+			schedule.failed()
+		} else {
+			schedule.recovered()
+		}
 
 		stateEntry := &State{
 			Name:      cfg.Name,
-			Status:    "ok",
+			Status:    status,
 			Details:   data,
 			CheckTime: time.Now(),
+			Duration:  duration,
 		}
 
 		if err != nil {
-			fmt.Printf("check %s has failed with error %v\n", cfg.Name, err)
 			stateEntry.Err = err.Error()
-			stateEntry.Status = "failed"
 		}
 
+		// safeUpdateState mutates stateEntry's ContiguousFailures/TimeOfFirstFailure
+		// in place before recording it, so log after it returns.
 		h.safeUpdateState(stateEntry)
 
+		if err != nil {
+			logger.Error("check failed", "status", status, "err", err, "duration", duration,
+				"contiguous_failures", stateEntry.ContiguousFailures)
+		} else {
+			logger.Debug("check passed", "status", status, "duration", duration)
+		}
+
+		// Run synchronously: checkFunc is already off the hot dial path
+		// (it runs in this check's own dedicated goroutine), and doing so
+		// guarantees callbacks for consecutive runs never overlap.
 		if cfg.OnComplete != nil {
-			go cfg.OnComplete(stateEntry)
+			cfg.OnComplete(stateEntry)
 		}
 	}
 
-	go func() {
+	select {
+	case <-time.After(time.Duration(h.RandomStartTimeMillis()) * time.Millisecond):
+	case <-ctx.Done():
+		return
+	}
 
-		time.Sleep(time.Duration(h.RandomStartTimeMillis()) * time.Millisecond)
-		fmt.Printf("%s Starting check %s\n", time.Now(), cfg.Name)
-		ticker := time.NewTicker(cfg.Interval)
-		defer ticker.Stop()
+	logger.Info("starting check")
 
-		checkFunc()
+	checkFunc()
 
-	RunLoop:
-		for {
-			select {
-			case <-ticker.C:
-				checkFunc()
-			case <-stop:
-				break RunLoop
-			}
-		}
+	timer := time.NewTimer(schedule.next())
+	defer timer.Stop()
 
-	}()
+RunLoop:
+	for {
+		select {
+		case <-timer.C:
+			checkFunc()
+			timer.Reset(schedule.next())
+		case <-ctx.Done():
+			break RunLoop
+		}
+	}
 }
 
 // resets the states in a concurrency-safe manner
@@ -238,30 +320,84 @@ func (h *Monitor) handleStatusListener(stateEntry *State) {
 	prevState := h.states[stateEntry.Name]
 	h.statesLock.Unlock()
 
-	// state is failure
-	if stateEntry.isFailure() {
-		if !prevState.isFailure() {
-			// new failure: previous state was ok
-			if h.StatusListener != nil {
-				go h.StatusListener.CheckFailed(stateEntry)
-			}
+	dispatch := func(fn func()) {
+		if h.StatusListener == nil {
+			return
+		}
+		fn()
+	}
 
+	// state is warning or critical
+	if stateEntry.isFailing() {
+		if !prevState.isFailing() {
+			// new failure: previous state was passing
 			stateEntry.TimeOfFirstFailure = time.Now()
 		} else {
 			// carry the time of first failure from the previous state
 			stateEntry.TimeOfFirstFailure = prevState.TimeOfFirstFailure
-			if h.StatusListener != nil {
-				go h.StatusListener.StillFailing(stateEntry, prevState.ContiguousFailures)
-			}
 		}
 		stateEntry.ContiguousFailures = prevState.ContiguousFailures + 1
 
-	} else if prevState.isFailure() {
-		// recovery, previous state was failure
+		switch {
+		case stateEntry.Status == StatusCritical && prevState.Status != StatusCritical:
+			dispatch(func() { h.StatusListener.CheckFailed(stateEntry) })
+		case stateEntry.Status == StatusWarning && prevState.Status != StatusWarning:
+			dispatch(func() { h.StatusListener.CheckWarning(stateEntry) })
+		case stateEntry.Status == StatusCritical:
+			dispatch(func() { h.StatusListener.StillFailing(stateEntry, prevState.ContiguousFailures) })
+		}
+
+	} else if prevState.isFailing() {
+		// recovery, previous state was warning or critical
 		failureSeconds := time.Now().Sub(prevState.TimeOfFirstFailure).Seconds()
 
-		if h.StatusListener != nil {
-			go h.StatusListener.CheckRecovered(stateEntry, prevState.ContiguousFailures, failureSeconds)
+		dispatch(func() { h.StatusListener.CheckRecovered(stateEntry, prevState.ContiguousFailures, failureSeconds) })
+	}
+}
+
+// statusHandler debounces the raw per-check results into the
+// externally-visible Status, only transitioning after the number of
+// consecutive same-kind results configured on Config has been reached.
+// This mirrors Consul's StatusHandler.
+type statusHandler struct {
+	cfg *Config
+
+	current      string
+	successCount int
+	failureCount int
+}
+
+func newStatusHandler(cfg *Config) *statusHandler {
+	return &statusHandler{
+		cfg:     cfg,
+		current: StatusPassing,
+	}
+}
+
+// next feeds in the result of a single check run and returns the
+// (possibly unchanged) externally-visible status.
+func (s *statusHandler) next(err error) string {
+	if err == nil {
+		s.failureCount = 0
+		s.successCount++
+		if s.successCount >= s.cfg.successBeforePassing() {
+			s.current = StatusPassing
 		}
+		return s.current
 	}
+
+	s.successCount = 0
+	s.failureCount++
+
+	var warnErr *WarningError
+	critical := !errors.As(err, &warnErr)
+
+	switch {
+	case critical && s.failureCount >= s.cfg.failuresBeforeCritical():
+		s.current = StatusCritical
+	case s.failureCount >= s.cfg.failuresBeforeWarning():
+		s.current = StatusWarning
+	}
+
+	return s.current
 }
@@ -2,11 +2,19 @@ package monitor
 
 import (
 	"fmt"
-	"github.com/stretchr/testify/require"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
+func init() {
+	// The tests below exercise sub-second intervals; relax the package
+	// default so AddCheck doesn't reject them.
+	MinInterval = time.Millisecond
+}
+
 func Test_AlreadyStarted(t *testing.T) {
 	mon := New()
 
@@ -66,8 +74,8 @@ func Test_HandlerGetsCalled(t *testing.T) {
 	defer mon.Stop()
 	time.Sleep(time.Second * 1)
 	require.GreaterOrEqual(t, len(handler.states), 1)
-	require.Equal(t, "ok", handler.states[0].Status)
-	require.Equal(t, "failed", handler.states[1].Status)
+	require.Equal(t, StatusPassing, handler.states[0].Status)
+	require.Equal(t, StatusCritical, handler.states[1].Status)
 }
 
 func Test_StatusListenerCalled(t *testing.T) {
@@ -82,8 +90,11 @@ func Test_StatusListenerCalled(t *testing.T) {
 	listener := mockStatusListener{}
 	mon.StatusListener = &listener
 	require.NoError(t, mon.Start())
-	defer mon.Stop()
 	time.Sleep(time.Second * 2)
+	// Stop before reading listener fields: it waits for every dispatched
+	// StatusListener callback to finish, so the reads below can't race
+	// with the callback goroutines.
+	require.NoError(t, mon.Stop())
 	require.Equal(t, 1, listener.checkFailedCalled)
 	require.Equal(t, 3, listener.stillFailingCalled)
 	require.Equal(t, 1, listener.checkRecoveredCalled)
@@ -118,8 +129,72 @@ func Test_StopNonExistingCheckShouldReturnError(t *testing.T) {
 	require.Error(t, mon.StopCheck("test_to_stop"))
 }
 
+func Test_AddCheck_RejectsIntervalBelowMinInterval(t *testing.T) {
+	mon := New()
+
+	err := mon.AddCheck(&Config{
+		Name:     "test",
+		Checker:  &mockChecker{},
+		Interval: MinInterval - time.Nanosecond,
+	})
+	require.Error(t, err)
+}
+
+func Test_AddCheck_RejectsInvalidJitterPercent(t *testing.T) {
+	mon := New()
+
+	err := mon.AddCheck(&Config{
+		Name:          "test",
+		Checker:       &mockChecker{},
+		Interval:      MinInterval,
+		JitterPercent: -1,
+	})
+	require.Error(t, err)
+
+	err = mon.AddCheck(&Config{
+		Name:          "test",
+		Checker:       &mockChecker{},
+		Interval:      MinInterval,
+		JitterPercent: 101,
+	})
+	require.Error(t, err)
+}
+
+// Test_ConcurrentStartStopCheck hammers StartCheck/StopCheck/Stop from many
+// goroutines at once; run with `-race` to prove Stop/StartCheck/StopCheck
+// don't race on the runners map and that Stop only returns once every
+// runner it cancelled has actually exited.
+func Test_ConcurrentStartStopCheck(t *testing.T) {
+	mon := New()
+
+	require.NoError(t, mon.AddCheck(&Config{
+		Name:       "test",
+		Checker:    &mockChecker{},
+		Interval:   time.Millisecond,
+		OnComplete: nil,
+	}))
+	require.NoError(t, mon.Start())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mon.StopCheck("test")
+			mon.StartCheck("test")
+		}()
+	}
+	wg.Wait()
+
+	require.NoError(t, mon.Stop())
+	state, err := mon.State()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(state))
+}
+
 type mockStatusListener struct {
 	checkFailedCalled    int
+	checkWarningCalled   int
 	checkRecoveredCalled int
 	stillFailingCalled   int
 }
@@ -128,6 +203,10 @@ func (m *mockStatusListener) CheckFailed(entry *State) {
 	m.checkFailedCalled = m.checkFailedCalled + 1
 }
 
+func (m *mockStatusListener) CheckWarning(entry *State) {
+	m.checkWarningCalled = m.checkWarningCalled + 1
+}
+
 func (m *mockStatusListener) CheckRecovered(entry *State, recordedFailures int64, failureDurationSeconds float64) {
 	m.checkRecoveredCalled = m.checkRecoveredCalled + 1
 }
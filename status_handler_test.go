@@ -0,0 +1,50 @@
+package monitor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatusHandler_WarningBeforeCritical(t *testing.T) {
+	cfg := &Config{
+		FailuresBeforeWarning:  2,
+		FailuresBeforeCritical: 4,
+	}
+	h := newStatusHandler(cfg)
+
+	require.Equal(t, StatusPassing, h.next(errors.New("failure")))
+	require.Equal(t, StatusWarning, h.next(errors.New("failure")))
+	require.Equal(t, StatusWarning, h.next(errors.New("failure")))
+	require.Equal(t, StatusCritical, h.next(errors.New("failure")))
+}
+
+func Test_StatusHandler_SuccessBeforePassingDebounce(t *testing.T) {
+	cfg := &Config{SuccessBeforePassing: 2}
+	h := newStatusHandler(cfg)
+
+	h.next(errors.New("failure"))
+	require.Equal(t, StatusCritical, h.current)
+
+	require.Equal(t, StatusCritical, h.next(nil), "one success should not clear critical yet")
+	require.Equal(t, StatusPassing, h.next(nil), "second consecutive success should clear critical")
+}
+
+func Test_StatusHandler_DefaultThresholdsGoStraightToCritical(t *testing.T) {
+	// FailuresBeforeWarning has no observable effect if left at its
+	// default of 1 alongside FailuresBeforeCritical's default of 1:
+	// critical is checked first in statusHandler.next, so the very
+	// first failure satisfies it before warning is ever reached.
+	h := newStatusHandler(&Config{})
+
+	require.Equal(t, StatusCritical, h.next(errors.New("failure")))
+}
+
+func Test_StatusHandler_WarningErrorNeverEscalatesToCritical(t *testing.T) {
+	h := newStatusHandler(&Config{})
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, StatusWarning, h.next(NewWarningError(errors.New("degraded"))))
+	}
+}
@@ -8,11 +8,44 @@ type Checker interface {
 	Status() (interface{}, error)
 }
 
+// WarningError marks a check failure as a warning rather than a critical
+// failure. Checkers that distinguish degraded-but-serviceable results from
+// outright failures should wrap the underlying error with
+// NewWarningError instead of returning it directly.
+type WarningError struct {
+	Err error
+}
+
+// NewWarningError wraps err so the runner reports the check as "warning"
+// instead of "critical" for this result.
+func NewWarningError(err error) *WarningError {
+	return &WarningError{Err: err}
+}
+
+func (w *WarningError) Error() string {
+	return w.Err.Error()
+}
+
+func (w *WarningError) Unwrap() error {
+	return w.Err
+}
+
+// The possible values of State.Status.
+const (
+	StatusPassing  = "passing"
+	StatusWarning  = "warning"
+	StatusCritical = "critical"
+)
+
 type StatusListener interface {
-	// CheckFailed is called when a health check state transitions from passing to failing.
+	// CheckFailed is called when a health check state transitions to critical.
 	// 	* entry - The recorded state of the health check that triggered the failure
 	CheckFailed(entry *State)
 
+	// CheckWarning is called when a health check state transitions to warning.
+	// 	* entry - The recorded state of the health check that triggered the warning
+	CheckWarning(entry *State)
+
 	// CheckRecovered is a function that handles the recovery of a failed health check.
 	// 	* entry - The recorded state of the health check that triggered the recovery
 	// 	* recordedFailures - the total failed health checks that lapsed
@@ -28,7 +61,7 @@ type State struct {
 	// Name of the health check
 	Name string `json:"name"`
 
-	// Status of the health check state ("ok" or "failed")
+	// Status of the health check state (StatusPassing, StatusWarning or StatusCritical)
 	Status string `json:"status"`
 
 	// Err is the error returned from a failed health check
@@ -41,11 +74,14 @@ type State struct {
 	// CheckTime is the time of the last health check
 	CheckTime time.Time `json:"check_time"`
 
+	// Duration is how long the last check run took.
+	Duration time.Duration `json:"duration"`
+
 	ContiguousFailures int64     `json:"num_failures"`     // the number of failures that occurred in a row
 	TimeOfFirstFailure time.Time `json:"first_failure_at"` // the time of the initial transitional failure for any given health check
 }
 
-// indicates state is failure
-func (s *State) isFailure() bool {
-	return s.Status == "failed"
+// isFailing indicates the state is not passing, i.e. warning or critical.
+func (s *State) isFailing() bool {
+	return s.Status == StatusWarning || s.Status == StatusCritical
 }